@@ -0,0 +1,283 @@
+package proposal
+
+import (
+	"bufio"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	govutils "github.com/cosmos/cosmos-sdk/x/gov/client/utils"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// Flags specific to the create/update pool proposal commands. The gov
+// "--deposit" flag from govutils.ProposalFlags is the governance deposit;
+// "--premium-deposit" is the shield pool's own premium deposit, so the two
+// cannot share a flag name.
+const (
+	FlagShield           = "shield"
+	FlagPremiumDeposit   = "premium-deposit"
+	FlagSponsor          = "sponsor"
+	FlagSponsorAddr      = "sponsor-addr"
+	FlagTimeOfCoverage   = "time-of-coverage"
+	FlagBlocksOfCoverage = "blocks-of-coverage"
+	FlagAdditionalTime   = "additional-time"
+	FlagAdditionalBlocks = "additional-blocks"
+)
+
+// GetCmdSubmitCreatePoolProposal implements the command to submit a
+// ShieldCreatePoolProposal through governance.
+func GetCmdSubmitCreatePoolProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-pool [flags]",
+		Args:  cobra.NoArgs,
+		Short: "Submit a shield create-pool proposal",
+		Long:  "Submit a proposal to create a new shield pool along with an initial governance deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			proposal, err := govutils.ParseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			govDeposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			shieldStr, err := cmd.Flags().GetString(FlagShield)
+			if err != nil {
+				return err
+			}
+			shield, err := sdk.ParseCoins(shieldStr)
+			if err != nil {
+				return err
+			}
+			premiumDepositStr, err := cmd.Flags().GetString(FlagPremiumDeposit)
+			if err != nil {
+				return err
+			}
+			premiumDeposit, err := sdk.ParseCoins(premiumDepositStr)
+			if err != nil {
+				return err
+			}
+			sponsor, err := cmd.Flags().GetString(FlagSponsor)
+			if err != nil {
+				return err
+			}
+			sponsorAddrStr, err := cmd.Flags().GetString(FlagSponsorAddr)
+			if err != nil {
+				return err
+			}
+			sponsorAddr, err := sdk.AccAddressFromBech32(sponsorAddrStr)
+			if err != nil {
+				return err
+			}
+			timeOfCoverage, err := cmd.Flags().GetInt64(FlagTimeOfCoverage)
+			if err != nil {
+				return err
+			}
+			blocksOfCoverage, err := cmd.Flags().GetInt64(FlagBlocksOfCoverage)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewShieldCreatePoolProposal(
+				proposal.Title, proposal.Description, shield, types.MixedCoins{Native: premiumDeposit},
+				sponsor, sponsorAddr, timeOfCoverage, blocksOfCoverage,
+			)
+
+			from := cliCtx.GetFromAddress()
+			msg := govtypes.NewMsgSubmitProposal(content, govDeposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(govutils.ProposalFlags)
+	cmd.Flags().String(FlagShield, "", "the shield coverage amount for the new pool")
+	cmd.Flags().String(FlagPremiumDeposit, "", "the initial native premium deposit for the new pool")
+	cmd.Flags().String(FlagSponsor, "", "the sponsor name for the new pool")
+	cmd.Flags().String(FlagSponsorAddr, "", "the sponsor address for the new pool")
+	cmd.Flags().Int64(FlagTimeOfCoverage, 0, "coverage duration in seconds")
+	cmd.Flags().Int64(FlagBlocksOfCoverage, 0, "coverage duration in blocks")
+	return cmd
+}
+
+// GetCmdSubmitUpdatePoolProposal implements the command to submit a
+// ShieldUpdatePoolProposal through governance.
+func GetCmdSubmitUpdatePoolProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-pool [pool-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a shield update-pool proposal",
+		Long:  "Submit a proposal to update an existing shield pool along with an initial governance deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			poolID, err := parsePoolID(args[0])
+			if err != nil {
+				return err
+			}
+
+			proposal, err := govutils.ParseProposalFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			govDeposit, err := sdk.ParseCoins(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			shieldStr, err := cmd.Flags().GetString(FlagShield)
+			if err != nil {
+				return err
+			}
+			shield, err := sdk.ParseCoins(shieldStr)
+			if err != nil {
+				return err
+			}
+			premiumDepositStr, err := cmd.Flags().GetString(FlagPremiumDeposit)
+			if err != nil {
+				return err
+			}
+			premiumDeposit, err := sdk.ParseCoins(premiumDepositStr)
+			if err != nil {
+				return err
+			}
+			additionalTime, err := cmd.Flags().GetInt64(FlagAdditionalTime)
+			if err != nil {
+				return err
+			}
+			additionalBlocks, err := cmd.Flags().GetInt64(FlagAdditionalBlocks)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewShieldUpdatePoolProposal(
+				proposal.Title, proposal.Description, poolID, shield, types.MixedCoins{Native: premiumDeposit},
+				additionalTime, additionalBlocks,
+			)
+
+			from := cliCtx.GetFromAddress()
+			msg := govtypes.NewMsgSubmitProposal(content, govDeposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().AddFlagSet(govutils.ProposalFlags)
+	cmd.Flags().String(FlagShield, "", "the additional shield coverage amount for the pool")
+	cmd.Flags().String(FlagPremiumDeposit, "", "the additional native premium deposit for the pool")
+	cmd.Flags().Int64(FlagAdditionalTime, 0, "additional coverage duration in seconds")
+	cmd.Flags().Int64(FlagAdditionalBlocks, 0, "additional coverage duration in blocks")
+	return cmd
+}
+
+// GetCmdSubmitPausePoolProposal implements the command to submit a
+// ShieldPausePoolProposal through governance.
+func GetCmdSubmitPausePoolProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-pool [pool-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a shield pause-pool proposal",
+		Long:  "Submit a proposal to pause a shield pool along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitLifecycleProposal(cdc, cmd, args, func(poolID uint64, title, description string) govtypes.Content {
+				return types.NewShieldPausePoolProposal(title, description, poolID)
+			})
+		},
+	}
+	cmd.Flags().AddFlagSet(govutils.ProposalFlags)
+	return cmd
+}
+
+// GetCmdSubmitResumePoolProposal implements the command to submit a
+// ShieldResumePoolProposal through governance.
+func GetCmdSubmitResumePoolProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-pool [pool-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a shield resume-pool proposal",
+		Long:  "Submit a proposal to resume a paused shield pool along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitLifecycleProposal(cdc, cmd, args, func(poolID uint64, title, description string) govtypes.Content {
+				return types.NewShieldResumePoolProposal(title, description, poolID)
+			})
+		},
+	}
+	cmd.Flags().AddFlagSet(govutils.ProposalFlags)
+	return cmd
+}
+
+// GetCmdSubmitClosePoolProposal implements the command to submit a
+// ShieldClosePoolProposal through governance.
+func GetCmdSubmitClosePoolProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-pool [pool-id] [flags]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a shield close-pool proposal",
+		Long:  "Submit a proposal to close a shield pool along with an initial deposit.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return submitLifecycleProposal(cdc, cmd, args, func(poolID uint64, title, description string) govtypes.Content {
+				return types.NewShieldClosePoolProposal(title, description, poolID)
+			})
+		},
+	}
+	cmd.Flags().AddFlagSet(govutils.ProposalFlags)
+	return cmd
+}
+
+// submitLifecycleProposal shares the boilerplate across the pool lifecycle
+// proposal commands: parse common gov proposal flags, build the content via
+// newContent, and broadcast a MsgSubmitProposal.
+func submitLifecycleProposal(
+	cdc *codec.Codec, cmd *cobra.Command, args []string,
+	newContent func(poolID uint64, title, description string) govtypes.Content,
+) error {
+	inBuf := bufio.NewReader(cmd.InOrStdin())
+	txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+	cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+	poolID, err := parsePoolID(args[0])
+	if err != nil {
+		return err
+	}
+
+	proposal, err := govutils.ParseProposalFlags(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	deposit, err := sdk.ParseCoins(proposal.Deposit)
+	if err != nil {
+		return err
+	}
+
+	content := newContent(poolID, proposal.Title, proposal.Description)
+
+	from := cliCtx.GetFromAddress()
+	msg := govtypes.NewMsgSubmitProposal(content, deposit, from)
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+}
+
+func parsePoolID(arg string) (uint64, error) {
+	return strconv.ParseUint(arg, 10, 64)
+}
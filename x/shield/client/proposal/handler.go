@@ -0,0 +1,33 @@
+package proposal
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/certikfoundation/shentu/x/shield/keeper"
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// NewPoolProposalHandler creates a new governance Handler for shield pool
+// lifecycle proposals (create/update/pause/resume/close), dispatching on
+// proposal.Content's concrete type the same way other module proposal
+// handlers in the chain do.
+func NewPoolProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case types.ShieldCreatePoolProposal:
+			return k.HandleShieldCreatePoolProposal(ctx, c)
+		case types.ShieldUpdatePoolProposal:
+			return k.HandleShieldUpdatePoolProposal(ctx, c)
+		case types.ShieldPausePoolProposal:
+			return k.HandleShieldPausePoolProposal(ctx, c)
+		case types.ShieldResumePoolProposal:
+			return k.HandleShieldResumePoolProposal(ctx, c)
+		case types.ShieldClosePoolProposal:
+			return k.HandleShieldClosePoolProposal(ctx, c)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized shield proposal content type: %T", c)
+		}
+	}
+}
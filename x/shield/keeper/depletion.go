@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// GetDepletionThreshold returns the governance-controlled fraction of a
+// pool's Shield that its backers' available delegation must still cover.
+func (k Keeper) GetDepletionThreshold(ctx sdk.Context) sdk.Dec {
+	var threshold sdk.Dec
+	if k.paramSpace.Has(ctx, types.KeyDepletionThreshold) {
+		k.paramSpace.Get(ctx, types.KeyDepletionThreshold, &threshold)
+		return threshold
+	}
+	return types.DefaultDepletionThreshold
+}
+
+// SetDepletionThreshold validates and stores the governance-controlled
+// depletion threshold. A non-positive threshold would never trip and one
+// above 1 could never be satisfied, so both are rejected.
+func (k Keeper) SetDepletionThreshold(ctx sdk.Context, threshold sdk.Dec) error {
+	if err := types.ValidateDepletionThreshold(threshold); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidDepletionThreshold, err.Error())
+	}
+	k.paramSpace.Set(ctx, types.KeyDepletionThreshold, threshold)
+	return nil
+}
+
+// poolBackers returns every address currently backing a pool: the admin
+// (via PoolCertiKCollateral) plus every PoolDeposit provider.
+func (k Keeper) poolBackers(ctx sdk.Context, pool types.Pool) []sdk.AccAddress {
+	backers := []sdk.AccAddress{k.GetAdmin(ctx)}
+	for _, deposit := range k.GetPoolDeposits(ctx, pool.PoolID) {
+		backers = append(backers, deposit.Provider)
+	}
+	return backers
+}
+
+// totalAvailableBacking sums Available bonded delegation across every
+// backer of a pool.
+func (k Keeper) totalAvailableBacking(ctx sdk.Context, pool types.Pool) sdk.Int {
+	total := sdk.ZeroInt()
+	for _, addr := range k.poolBackers(ctx, pool) {
+		provider, found := k.GetProvider(ctx, addr)
+		if !found {
+			continue
+		}
+		total = total.Add(provider.Available)
+	}
+	return total
+}
+
+// CheckPoolDepletion marks a pool Depleted and inactive when its backers'
+// total available delegation falls below pool.Shield * depletionThreshold,
+// emitting a pool_depleted event. It is a no-op for already-depleted or
+// already-inactive pools.
+func (k Keeper) CheckPoolDepletion(ctx sdk.Context, pool types.Pool) {
+	if pool.Depleted || !pool.Active {
+		return
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	required := sdk.NewDecFromInt(pool.Shield.AmountOf(bondDenom)).Mul(k.GetDepletionThreshold(ctx))
+	available := k.totalAvailableBacking(ctx, pool)
+
+	if sdk.NewDecFromInt(available).LT(required) {
+		pool.Depleted = true
+		pool.Active = false
+		k.SetPool(ctx, pool)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePoolDepleted,
+				sdk.NewAttribute(types.AttributeKeyPoolID, sdk.NewUint(pool.PoolID).String()),
+				sdk.NewAttribute(types.AttributeKeyTotalAvailable, available.String()),
+				sdk.NewAttribute(types.AttributeKeyRequiredBacking, required.String()),
+			),
+		)
+	}
+}
+
+// ClearDepletionIfRestored un-marks a Depleted pool once its backers' total
+// available delegation once again covers pool.Shield * depletionThreshold.
+// It does not reactivate the pool; that still requires an explicit
+// ResumePool once Depleted is cleared.
+func (k Keeper) ClearDepletionIfRestored(ctx sdk.Context, pool types.Pool) {
+	if !pool.Depleted {
+		return
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	required := sdk.NewDecFromInt(pool.Shield.AmountOf(bondDenom)).Mul(k.GetDepletionThreshold(ctx))
+	available := k.totalAvailableBacking(ctx, pool)
+
+	if sdk.NewDecFromInt(available).GTE(required) {
+		pool.Depleted = false
+		k.SetPool(ctx, pool)
+	}
+}
+
+// EndBlocker runs CheckPoolDepletion against every active pool.
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, pool := range k.GetAllPools(ctx) {
+		k.CheckPoolDepletion(ctx, pool)
+	}
+}
+
+// EnsurePoolNotDepleted returns ErrPoolDepleted if the pool is depleted. A
+// depleted pool must reject new PurchaseShield messages until it is topped
+// back up via UpdatePool or DepositCollateral.
+func (k Keeper) EnsurePoolNotDepleted(ctx sdk.Context, pool types.Pool) error {
+	if pool.Depleted {
+		return types.ErrPoolDepleted
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// SetPoolDeposit sets a PoolDeposit record in the store.
+func (k Keeper) SetPoolDeposit(ctx sdk.Context, poolID uint64, provider sdk.AccAddress, deposit types.PoolDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(deposit)
+	store.Set(types.GetPoolDepositKey(poolID, provider), bz)
+}
+
+// GetPoolDeposit retrieves a provider's PoolDeposit for the given pool.
+func (k Keeper) GetPoolDeposit(ctx sdk.Context, poolID uint64, provider sdk.AccAddress) (types.PoolDeposit, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolDepositKey(poolID, provider))
+	if bz == nil {
+		return types.PoolDeposit{}, types.ErrNoPoolDepositFound
+	}
+	var deposit types.PoolDeposit
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deposit)
+	return deposit, nil
+}
+
+// deletePoolDeposit removes a PoolDeposit record from the store.
+func (k Keeper) deletePoolDeposit(ctx sdk.Context, poolID uint64, provider sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolDepositKey(poolID, provider))
+}
+
+// GetPoolDeposits returns every PoolDeposit backing the given pool.
+func (k Keeper) GetPoolDeposits(ctx sdk.Context, poolID uint64) (deposits []types.PoolDeposit) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetPoolDepositsKey(poolID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.PoolDeposit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+		deposits = append(deposits, deposit)
+	}
+	return deposits
+}
+
+// recomputeShares recomputes SharePercent for every PoolDeposit backing the
+// pool, based on the pool's current TotalCollateral. It is called after any
+// deposit, withdrawal, or pool mutation that changes TotalCollateral.
+func (k Keeper) recomputeShares(ctx sdk.Context, pool types.Pool) {
+	bondDenom := k.sk.BondDenom(ctx)
+	total := sdk.NewDecFromInt(pool.TotalCollateral.AmountOf(bondDenom))
+	for _, deposit := range k.GetPoolDeposits(ctx, pool.PoolID) {
+		if total.IsZero() {
+			deposit.SharePercent = sdk.ZeroDec()
+		} else {
+			deposit.SharePercent = sdk.NewDecFromInt(deposit.Collateral.AmountOf(bondDenom)).Quo(total)
+		}
+		k.SetPoolDeposit(ctx, pool.PoolID, deposit.Provider, deposit)
+	}
+}
+
+// DepositCollateral lets a provider back a pool with additional collateral,
+// drawing from the provider's available bonded delegation. It creates a new
+// PoolDeposit if the provider has not yet backed this pool.
+func (k Keeper) DepositCollateral(ctx sdk.Context, provider sdk.AccAddress, poolID uint64, collateral sdk.Coins) error {
+	pool, err := k.GetPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	shieldProvider, found := k.GetProvider(ctx, provider)
+	if !found {
+		k.addProvider(ctx, provider)
+		shieldProvider, _ = k.GetProvider(ctx, provider)
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	if collateral.AmountOf(bondDenom).GT(shieldProvider.Available) {
+		return sdkerrors.Wrapf(types.ErrInsufficientStaking,
+			"available %s, collateral %s", shieldProvider.Available, collateral)
+	}
+	deposit, err := k.GetPoolDeposit(ctx, poolID, provider)
+	if err != nil {
+		deposit = types.NewPoolDeposit(poolID, provider, sdk.Coins{})
+	}
+	// Settle the reward accrued on the provider's pre-deposit collateral
+	// before the snapshot reset below would otherwise forfeit it.
+	if err := k.PayOutAccruedShieldReward(ctx, poolID, provider, deposit.Collateral); err != nil {
+		return err
+	}
+
+	shieldProvider.Collateral = shieldProvider.Collateral.Add(collateral...)
+	shieldProvider.Available = shieldProvider.Available.Sub(collateral.AmountOf(bondDenom))
+	k.SetProvider(ctx, provider, shieldProvider)
+
+	k.SnapshotPremiumStartInfo(ctx, poolID, provider)
+
+	deposit.Collateral = deposit.Collateral.Add(collateral...)
+	k.SetPoolDeposit(ctx, poolID, provider, deposit)
+
+	pool.TotalCollateral = pool.TotalCollateral.Add(collateral...)
+	k.SetPool(ctx, pool)
+	k.recomputeShares(ctx, pool)
+	k.ClearDepletionIfRestored(ctx, pool)
+
+	return nil
+}
+
+// WithdrawFromPoolDeposit lets a provider withdraw some of its collateral
+// backing a pool, releasing it back to the provider's available delegation.
+func (k Keeper) WithdrawFromPoolDeposit(ctx sdk.Context, provider sdk.AccAddress, poolID uint64, collateral sdk.Coins) error {
+	pool, err := k.GetPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+
+	deposit, err := k.GetPoolDeposit(ctx, poolID, provider)
+	if err != nil {
+		return err
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	if collateral.AmountOf(bondDenom).GT(deposit.Collateral.AmountOf(bondDenom)) {
+		return types.ErrInsufficientDeposit
+	}
+	// Settle the reward accrued on the provider's pre-withdrawal collateral
+	// before the snapshot reset below would otherwise forfeit it.
+	if err := k.PayOutAccruedShieldReward(ctx, poolID, provider, deposit.Collateral); err != nil {
+		return err
+	}
+	k.SnapshotPremiumStartInfo(ctx, poolID, provider)
+	deposit.Collateral = deposit.Collateral.Sub(collateral)
+
+	shieldProvider, found := k.GetProvider(ctx, provider)
+	if !found {
+		return types.ErrNoDelegationAmount
+	}
+	shieldProvider.Collateral = shieldProvider.Collateral.Sub(collateral)
+	shieldProvider.Available = shieldProvider.Available.Add(collateral.AmountOf(bondDenom))
+	k.SetProvider(ctx, provider, shieldProvider)
+
+	if deposit.Collateral.Empty() || deposit.Collateral.IsZero() {
+		k.deletePoolDeposit(ctx, poolID, provider)
+		k.deletePremiumStartInfo(ctx, poolID, provider)
+	} else {
+		k.SetPoolDeposit(ctx, poolID, provider, deposit)
+	}
+
+	pool.TotalCollateral = pool.TotalCollateral.Sub(collateral)
+	k.SetPool(ctx, pool)
+	k.recomputeShares(ctx, pool)
+
+	return nil
+}
+
+// clampSubCoin subtracts amt (in denom) from coins, floored at zero, so a
+// rounding-driven overshoot can never panic the caller.
+func clampSubCoin(coins sdk.Coins, denom string, amt sdk.Int) sdk.Coins {
+	have := coins.AmountOf(denom)
+	if amt.GT(have) {
+		amt = have
+	}
+	result, negative := coins.SafeSub(sdk.NewCoins(sdk.NewCoin(denom, amt)))
+	if negative {
+		return coins
+	}
+	return result
+}
+
+// payoutShare floors share*payoutAmt to a backer's deduction, clamped so it
+// never exceeds the backer's own collateral.
+func payoutShare(payoutAmt sdk.Dec, share sdk.Dec, backerAmt sdk.Int) sdk.Int {
+	deduction := payoutAmt.Mul(share).TruncateInt()
+	if deduction.GT(backerAmt) {
+		deduction = backerAmt
+	}
+	return deduction
+}
+
+// PayoutFromPoolDeposits draws a claim payout proportionally from every
+// backer of the pool - the admin's PoolCertiKCollateral as well as every
+// PoolDeposit - rather than only from the admin's PoolCertiKCollateral.
+// PoolDeposit backers are drawn from using their maintained SharePercent
+// rather than re-deriving Collateral/TotalCollateral (PoolCertiKCollateral
+// has no such field, so the admin's portion is still computed that way).
+// Each backer's deduction is clamped to what it actually holds, and
+// TotalCollateral is reconciled to the sum actually deducted rather than
+// the requested payout, since floor rounding across backers can fall short
+// of the exact payout.
+func (k Keeper) PayoutFromPoolDeposits(ctx sdk.Context, poolID uint64, payout sdk.Coins) error {
+	pool, err := k.GetPool(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	bondDenom := k.sk.BondDenom(ctx)
+	totalAmt := pool.TotalCollateral.AmountOf(bondDenom)
+	if !totalAmt.IsPositive() {
+		return nil
+	}
+	payoutAmt := sdk.NewDecFromInt(payout.AmountOf(bondDenom))
+	totalDec := sdk.NewDecFromInt(totalAmt)
+	deducted := sdk.ZeroInt()
+
+	admin := k.GetAdmin(ctx)
+	if poolCertiKCollateral, found := k.GetPoolCertiKCollateral(ctx, pool); found {
+		adminAmt := poolCertiKCollateral.Amount.AmountOf(bondDenom)
+		adminSharePercent := sdk.NewDecFromInt(adminAmt).Quo(totalDec)
+		share := payoutShare(payoutAmt, adminSharePercent, adminAmt)
+		if share.IsPositive() {
+			poolCertiKCollateral.Amount = clampSubCoin(poolCertiKCollateral.Amount, bondDenom, share)
+			k.SetCollateral(ctx, pool, admin, poolCertiKCollateral)
+
+			if shieldProvider, found := k.GetProvider(ctx, admin); found {
+				shieldProvider.Collateral = clampSubCoin(shieldProvider.Collateral, bondDenom, share)
+				k.SetProvider(ctx, admin, shieldProvider)
+			}
+			deducted = deducted.Add(share)
+		}
+	}
+
+	for _, deposit := range k.GetPoolDeposits(ctx, poolID) {
+		depositAmt := deposit.Collateral.AmountOf(bondDenom)
+		share := payoutShare(payoutAmt, deposit.SharePercent, depositAmt)
+		if !share.IsPositive() {
+			continue
+		}
+		deposit.Collateral = clampSubCoin(deposit.Collateral, bondDenom, share)
+		k.SetPoolDeposit(ctx, poolID, deposit.Provider, deposit)
+
+		if shieldProvider, found := k.GetProvider(ctx, deposit.Provider); found {
+			shieldProvider.Collateral = clampSubCoin(shieldProvider.Collateral, bondDenom, share)
+			k.SetProvider(ctx, deposit.Provider, shieldProvider)
+		}
+		deducted = deducted.Add(share)
+	}
+
+	pool.TotalCollateral = clampSubCoin(pool.TotalCollateral, bondDenom, deducted)
+	k.SetPool(ctx, pool)
+	k.recomputeShares(ctx, pool)
+	return nil
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// GetCumulativePremiumPerShare returns a pool's running premium-per-share
+// accumulator, defaulting to zero if the pool has not yet received premium.
+func (k Keeper) GetCumulativePremiumPerShare(ctx sdk.Context, poolID uint64) sdk.DecCoins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCumulativePremiumPerShareKey(poolID))
+	if bz == nil {
+		return sdk.DecCoins{}
+	}
+	var cumulative sdk.DecCoins
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &cumulative)
+	return cumulative
+}
+
+func (k Keeper) setCumulativePremiumPerShare(ctx sdk.Context, poolID uint64, cumulative sdk.DecCoins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(cumulative)
+	store.Set(types.GetCumulativePremiumPerShareKey(poolID), bz)
+}
+
+// IncrementCumulativePremiumPerShare adds premium/pool.TotalCollateral to the
+// pool's accumulator. It is called every time native premium is deposited
+// into a pool, e.g. from CreatePool, UpdatePool, or a shield purchase.
+func (k Keeper) IncrementCumulativePremiumPerShare(ctx sdk.Context, pool types.Pool, premium sdk.Coins) {
+	bondDenom := k.sk.BondDenom(ctx)
+	total := pool.TotalCollateral.AmountOf(bondDenom)
+	if !total.IsPositive() || premium.Empty() {
+		return
+	}
+	perShare := sdk.NewDecCoinsFromCoins(premium...).
+		QuoDecTruncate(sdk.NewDecFromInt(total))
+	cumulative := k.GetCumulativePremiumPerShare(ctx, pool.PoolID).Add(perShare...)
+	k.setCumulativePremiumPerShare(ctx, pool.PoolID, cumulative)
+}
+
+// GetPremiumStartInfo retrieves a provider's premium start snapshot for a
+// pool.
+func (k Keeper) GetPremiumStartInfo(ctx sdk.Context, poolID uint64, provider sdk.AccAddress) (types.PremiumStartInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPremiumStartInfoKey(poolID, provider))
+	if bz == nil {
+		return types.PremiumStartInfo{}, false
+	}
+	var info types.PremiumStartInfo
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &info)
+	return info, true
+}
+
+func (k Keeper) setPremiumStartInfo(ctx sdk.Context, info types.PremiumStartInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(info)
+	store.Set(types.GetPremiumStartInfoKey(info.PoolID, info.Provider), bz)
+}
+
+func (k Keeper) deletePremiumStartInfo(ctx sdk.Context, poolID uint64, provider sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPremiumStartInfoKey(poolID, provider))
+}
+
+// shieldRewardForProvider computes the premium reward a provider has accrued
+// in a pool since its last snapshot, given its current collateral.
+func (k Keeper) shieldRewardForProvider(ctx sdk.Context, poolID uint64, provider sdk.AccAddress, collateral sdk.Coins) sdk.DecCoins {
+	startInfo, found := k.GetPremiumStartInfo(ctx, poolID, provider)
+	if !found {
+		return sdk.DecCoins{}
+	}
+	cumulative := k.GetCumulativePremiumPerShare(ctx, poolID)
+	perShareDelta := cumulative.Sub(startInfo.CumulativeAtJoin)
+	bondDenom := k.sk.BondDenom(ctx)
+	return perShareDelta.MulDecTruncate(sdk.NewDecFromInt(collateral.AmountOf(bondDenom)))
+}
+
+// SnapshotPremiumStartInfo resets a provider's premium start snapshot to the
+// pool's current accumulator value. It must be called whenever the
+// provider's collateral in the pool is about to change (deposit, withdrawal,
+// CreatePool, UpdatePool) so that rewards only accrue for time-in-pool.
+//
+// Resetting the snapshot zeroes the (cumulative - CumulativeAtJoin) delta
+// shieldRewardForProvider relies on, so any reward accrued since the last
+// snapshot must be paid out first via PayOutAccruedShieldReward - otherwise
+// it is silently forfeited, the same way x/distribution withdraws pending
+// rewards before a delegation's stake changes.
+func (k Keeper) SnapshotPremiumStartInfo(ctx sdk.Context, poolID uint64, provider sdk.AccAddress) {
+	cumulative := k.GetCumulativePremiumPerShare(ctx, poolID)
+	k.setPremiumStartInfo(ctx, types.NewPremiumStartInfo(poolID, provider, cumulative))
+}
+
+// PayOutAccruedShieldReward pays a provider's premium reward accrued since
+// its last snapshot, using its collateral as of immediately before the
+// change that is about to happen. It does not touch the snapshot itself -
+// callers must still call SnapshotPremiumStartInfo afterwards - so it can be
+// used to settle pending rewards ahead of a cumulative-accumulator increment
+// that the provider's own snapshot should exclude (see CreatePool/UpdatePool).
+// It is a no-op if nothing has accrued.
+func (k Keeper) PayOutAccruedShieldReward(ctx sdk.Context, poolID uint64, provider sdk.AccAddress, collateral sdk.Coins) error {
+	reward, _ := k.shieldRewardForProvider(ctx, poolID, provider, collateral).TruncateDecimal()
+	if reward.Empty() {
+		return nil
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, provider, reward)
+}
+
+// WithdrawShieldRewardsFromPool pays out a provider's accrued premium reward
+// from the given pool's module account balance and resets its snapshot.
+func (k Keeper) WithdrawShieldRewardsFromPool(ctx sdk.Context, delegator sdk.AccAddress, poolID uint64) (sdk.Coins, error) {
+	deposit, err := k.GetPoolDeposit(ctx, poolID, delegator)
+	collateral := deposit.Collateral
+	if err != nil {
+		admin := k.GetAdmin(ctx)
+		if !delegator.Equals(admin) {
+			return nil, err
+		}
+		poolCertiKCollateral, found := k.GetPoolCertiKCollateral(ctx, types.Pool{PoolID: poolID})
+		if !found {
+			return nil, types.ErrNoPremiumStartInfoFound
+		}
+		collateral = poolCertiKCollateral.Amount
+	}
+
+	reward, _ := k.shieldRewardForProvider(ctx, poolID, delegator, collateral).TruncateDecimal()
+	if reward.Empty() {
+		return nil, types.ErrNoRewardsToWithdraw
+	}
+
+	if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, delegator, reward); err != nil {
+		return nil, err
+	}
+
+	k.SnapshotPremiumStartInfo(ctx, poolID, delegator)
+	return reward, nil
+}
+
+// WithdrawShieldRewards pays out a delegator's accrued premium reward across
+// every pool it backs, as either a PoolDeposit provider or the pool admin.
+func (k Keeper) WithdrawShieldRewards(ctx sdk.Context, delegator sdk.AccAddress) (sdk.Coins, error) {
+	total := sdk.Coins{}
+	for _, pool := range k.GetAllPools(ctx) {
+		reward, err := k.WithdrawShieldRewardsFromPool(ctx, delegator, pool.PoolID)
+		if err != nil {
+			continue
+		}
+		total = total.Add(reward...)
+	}
+	if total.Empty() {
+		return nil, types.ErrNoRewardsToWithdraw
+	}
+	return total, nil
+}
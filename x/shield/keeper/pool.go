@@ -1,6 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -71,6 +75,13 @@ func (k Keeper) CreatePool(
 	k.SetNextPoolID(ctx, id+1)
 	k.SetProvider(ctx, admin, provider)
 	k.SetCollateral(ctx, pool, admin, types.NewCollateral(pool, admin, shield))
+	k.SeedPoolReserve(ctx, id, shield.AmountOf(k.sk.BondDenom(ctx)), deposit.Native.AmountOf(k.sk.BondDenom(ctx)))
+	// Increment the accumulator with the creation premium before snapshotting
+	// the admin's start info, so the admin's own contributed premium is
+	// excluded from its accrual and rewards only reflect time-in-pool after
+	// creation.
+	k.IncrementCumulativePremiumPerShare(ctx, pool, deposit.Native)
+	k.SnapshotPremiumStartInfo(ctx, id, admin)
 
 	return pool, nil
 }
@@ -116,6 +127,12 @@ func (k Keeper) UpdatePool(
 	if !found {
 		poolCertiKCollateral = types.NewCollateral(pool, admin, sdk.Coins{})
 	}
+	// Settle the admin's reward accrued on its pre-update collateral before
+	// that collateral (and the accumulator, below) change underneath it, so
+	// the update doesn't silently forfeit it.
+	if err := k.PayOutAccruedShieldReward(ctx, id, admin, poolCertiKCollateral.Amount); err != nil {
+		return types.Pool{}, err
+	}
 	poolCertiKCollateral.Amount = poolCertiKCollateral.Amount.Add(shield...)
 
 	pool.Shield = pool.Shield.Add(shield...)
@@ -130,6 +147,16 @@ func (k Keeper) UpdatePool(
 	k.SetCollateral(ctx, pool, k.GetAdmin(ctx), poolCertiKCollateral)
 	k.SetPool(ctx, pool)
 	k.SetProvider(ctx, admin, provider)
+	if err := k.AdjustPoolReserve(ctx, id, shield.AmountOf(k.sk.BondDenom(ctx))); err != nil {
+		return types.Pool{}, err
+	}
+	// Increment the accumulator with this deposit's premium before
+	// snapshotting the admin's start info, so the admin's own contributed
+	// premium is excluded from its accrual and rewards only reflect
+	// time-in-pool after this update.
+	k.IncrementCumulativePremiumPerShare(ctx, pool, deposit.Native)
+	k.SnapshotPremiumStartInfo(ctx, id, admin)
+	k.ClearDepletionIfRestored(ctx, pool)
 	return pool, nil
 }
 
@@ -162,6 +189,9 @@ func (k Keeper) ResumePool(ctx sdk.Context, updater sdk.AccAddress, id uint64) (
 	if pool.Active {
 		return types.Pool{}, types.ErrPoolAlreadyActive
 	}
+	if pool.Depleted {
+		return types.Pool{}, types.ErrPoolDepleted
+	}
 	pool.Active = true
 	k.SetPool(ctx, pool)
 	return pool, nil
@@ -176,18 +206,20 @@ func (k Keeper) GetAllPools(ctx sdk.Context) (pools []types.Pool) {
 	return pools
 }
 
-// PoolEnded returns if pool has reached ending time and block height
+// PoolEnded returns if pool has reached ending time and block height, or has
+// been marked Depleted and never topped back up.
 func (k Keeper) PoolEnded(ctx sdk.Context, pool types.Pool) bool {
 	if ctx.BlockTime().Unix() > pool.EndTime && ctx.BlockHeight() > pool.EndBlockHeight {
 		return true
 	}
-	return false
+	return pool.Depleted
 }
 
 // ClosePool closes the pool
 func (k Keeper) ClosePool(ctx sdk.Context, pool types.Pool) {
 	// TODO: make sure nothing else needs to be done
 	k.FreeCollaterals(ctx, pool)
+	k.deletePoolReserve(ctx, pool.PoolID)
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetPoolKey(pool.PoolID))
 }
@@ -215,40 +247,93 @@ func (k Keeper) ValidatePoolDuration(ctx sdk.Context, timeDuration, numBlocks in
 	return timeDuration > minPoolDuration || numBlocks*5 > minPoolDuration
 }
 
-// WithdrawFromPools withdraws coins from all pools to match total collateral to be less than or equal to total delegation.
-func (k Keeper) WithdrawFromPools(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) {
+// WithdrawFromPools withdraws coins from all pools to match total collateral
+// to be less than or equal to total delegation. Per-collateral amounts are
+// apportioned by the largest-remainder (Hamilton) method: each collateral
+// gets its floored proportional share, and the leftover units from rounding
+// go one at a time to the collaterals with the largest fractional
+// remainders, ties broken by PoolID ascending for determinism. This keeps
+// the sum exact without the old ad-hoc "+1 on the last remainder" bump.
+func (k Keeper) WithdrawFromPools(ctx sdk.Context, addr sdk.AccAddress, amount sdk.Coins) error {
 	bondDenom := k.sk.BondDenom(ctx)
 	provider, _ := k.GetProvider(ctx, addr)
-	withdrawAmtDec := sdk.NewDecFromInt(amount.AmountOf(bondDenom))
-	withdrawableAmtDec := sdk.NewDecFromInt(provider.Collateral.AmountOf(bondDenom).Sub(provider.Withdraw))
-	proportion := withdrawAmtDec.Quo(withdrawableAmtDec)
-	if amount.AmountOf(bondDenom).ToDec().GT(withdrawableAmtDec) {
-		// FIXME this could happen. Set an error instead of panic.
-		panic(types.ErrNotEnoughCollateral)
+	withdrawAmt := amount.AmountOf(bondDenom)
+	withdrawableAmt := provider.Collateral.AmountOf(bondDenom).Sub(provider.Withdraw)
+	if withdrawAmt.GT(withdrawableAmt) {
+		return types.ErrNotEnoughCollateral
 	}
+	if !withdrawAmt.IsPositive() {
+		return nil
+	}
+	proportion := sdk.NewDecFromInt(withdrawAmt).Quo(sdk.NewDecFromInt(withdrawableAmt))
 
 	addrCollaterals := k.GetOnesCollaterals(ctx, addr)
-	remainingWithdraw := amount
+	if len(addrCollaterals) == 0 {
+		return types.ErrNotEnoughCollateral
+	}
+	sort.Slice(addrCollaterals, func(i, j int) bool {
+		return addrCollaterals[i].PoolID < addrCollaterals[j].PoolID
+	})
+
+	type allotment struct {
+		collateral types.Collateral
+		amount     sdk.Int
+		remainder  sdk.Dec
+	}
+	allotments := make([]allotment, len(addrCollaterals))
+	allotted := sdk.ZeroInt()
 	for i, collateral := range addrCollaterals {
-		var withdrawAmt sdk.Int
-		if i == len(addrCollaterals)-1 {
-			withdrawAmt = remainingWithdraw.AmountOf(bondDenom)
-		} else {
-			withdrawable := collateral.Amount.AmountOf(bondDenom).Sub(collateral.Withdrawing.AmountOf(bondDenom))
-			withdrawAmtDec := sdk.NewDecFromInt(withdrawable).Mul(proportion)
-			withdrawAmt = withdrawAmtDec.TruncateInt()
-			if remainingWithdraw.AmountOf(bondDenom).LTE(withdrawAmt) {
-				withdrawAmt = remainingWithdraw.AmountOf(bondDenom)
-			} else if remainingWithdraw.AmountOf(bondDenom).GT(withdrawAmt) && withdrawable.GT(withdrawAmt) {
-				withdrawAmt = withdrawAmt.Add(sdk.NewInt(1))
-			}
-		}
-		withdrawCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, withdrawAmt))
-		err := k.WithdrawCollateral(ctx, addr, collateral.PoolID, withdrawCoins)
-		if err != nil {
-			//TODO: address this error
+		withdrawable := collateral.Amount.AmountOf(bondDenom).Sub(collateral.Withdrawing.AmountOf(bondDenom))
+		alloc := sdk.NewDecFromInt(withdrawable).Mul(proportion)
+		floor := alloc.TruncateInt()
+		allotments[i] = allotment{collateral: collateral, amount: floor, remainder: alloc.Sub(floor.ToDec())}
+		allotted = allotted.Add(floor)
+	}
+
+	// Distribute the rounding remainder one unit at a time to the entries
+	// with the largest fractional remainders (ties already broken by PoolID
+	// ascending, since sort.SliceStable preserves the prior ordering). This
+	// loop is bounded by len(allotments) regardless of how many units are
+	// left over or short, so it can never index out of range; any leftover
+	// beyond one unit per entry (only possible from Dec rounding of
+	// `proportion` itself) is folded into the single largest-remainder
+	// entry so the total withdrawn always matches `amount` exactly.
+	remaining := withdrawAmt.Sub(allotted)
+	if remaining.IsNegative() {
+		// The floors alone already exceed withdrawAmt. That can only happen
+		// if provider.Collateral has drifted from Σcollateral.Amount, which
+		// every deposit/withdrawal is supposed to keep in sync; surface it
+		// instead of folding a negative leftover into an allotment, which
+		// would silently under-withdraw once that entry fails IsPositive().
+		return sdkerrors.Wrap(types.ErrNotEnoughCollateral, "collateral allotments exceed requested withdrawal")
+	}
+	sort.SliceStable(allotments, func(i, j int) bool {
+		return allotments[i].remainder.GT(allotments[j].remainder)
+	})
+	n := sdk.NewInt(int64(len(allotments)))
+	give := remaining
+	if give.GT(n) {
+		give = n
+	}
+	for i := int64(0); i < give.Int64(); i++ {
+		allotments[i].amount = allotments[i].amount.Add(sdk.OneInt())
+	}
+	if leftover := remaining.Sub(give); !leftover.IsZero() {
+		allotments[0].amount = allotments[0].amount.Add(leftover)
+	}
+
+	var failures []string
+	for _, a := range allotments {
+		if !a.amount.IsPositive() {
 			continue
 		}
-		remainingWithdraw = remainingWithdraw.Sub(withdrawCoins)
+		withdrawCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, a.amount))
+		if err := k.WithdrawCollateral(ctx, addr, a.collateral.PoolID, withdrawCoins); err != nil {
+			failures = append(failures, fmt.Sprintf("pool %d: %s", a.collateral.PoolID, err))
+		}
 	}
-}
\ No newline at end of file
+	if len(failures) > 0 {
+		return sdkerrors.Wrap(types.ErrNotEnoughCollateral, strings.Join(failures, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// GetFeeRate returns the governance-controlled fee rate applied on top of
+// the constant-product premium quote.
+func (k Keeper) GetFeeRate(ctx sdk.Context) sdk.Dec {
+	var feeRate sdk.Dec
+	if k.paramSpace.Has(ctx, types.KeyFeeRate) {
+		k.paramSpace.Get(ctx, types.KeyFeeRate, &feeRate)
+		return feeRate
+	}
+	return types.DefaultFeeRate
+}
+
+// SetFeeRate validates and stores the governance-controlled fee rate. It
+// must be used instead of writing to the param store directly, since an
+// out-of-range fee rate would divide by zero (feeRate == 1) or invert the
+// premium (feeRate > 1) in QuotePremium.
+func (k Keeper) SetFeeRate(ctx sdk.Context, feeRate sdk.Dec) error {
+	if err := types.ValidateFeeRate(feeRate); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalidFeeRate, err.Error())
+	}
+	k.paramSpace.Set(ctx, types.KeyFeeRate, feeRate)
+	return nil
+}
+
+// SetPoolReserve sets a pool's constant-product reserve pair.
+func (k Keeper) SetPoolReserve(ctx sdk.Context, reserve types.PoolReserve) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(reserve)
+	store.Set(types.GetPoolReserveKey(reserve.PoolID), bz)
+}
+
+// GetPoolReserve retrieves a pool's constant-product reserve pair.
+func (k Keeper) GetPoolReserve(ctx sdk.Context, poolID uint64) (types.PoolReserve, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPoolReserveKey(poolID))
+	if bz == nil {
+		return types.PoolReserve{}, types.ErrNoPoolReserveFound
+	}
+	var reserve types.PoolReserve
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &reserve)
+	return reserve, nil
+}
+
+// deletePoolReserve removes a pool's reserve pair, e.g. when the pool closes.
+func (k Keeper) deletePoolReserve(ctx sdk.Context, poolID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPoolReserveKey(poolID))
+}
+
+// SeedPoolReserve initializes a pool's virtual reserves from its initial
+// shield coverage and native premium deposit. Called once, on pool creation.
+func (k Keeper) SeedPoolReserve(ctx sdk.Context, poolID uint64, shield, premium sdk.Int) {
+	k.SetPoolReserve(ctx, types.NewPoolReserve(poolID, shield, premium))
+}
+
+// AdjustPoolReserve proportionally scales a pool's reserves when its shield
+// coverage changes outside of a purchase, e.g. via UpdatePool. deltaShield
+// may be negative. ClosePool does not call it; it deletes the reserve pair.
+func (k Keeper) AdjustPoolReserve(ctx sdk.Context, poolID uint64, deltaShield sdk.Int) error {
+	reserve, err := k.GetPoolReserve(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	if reserve.ShieldReserve.IsZero() {
+		return nil
+	}
+	proportion := sdk.NewDecFromInt(reserve.ShieldReserve.Add(deltaShield)).Quo(sdk.NewDecFromInt(reserve.ShieldReserve))
+	reserve.ShieldReserve = reserve.ShieldReserve.Add(deltaShield)
+	reserve.PremiumReserve = sdk.NewDecFromInt(reserve.PremiumReserve).Mul(proportion).TruncateInt()
+	k.SetPoolReserve(ctx, reserve)
+	return nil
+}
+
+// quotePremium computes the pre-fee premium required to purchase shieldOut
+// coverage from the pool's constant-product curve, alongside the reserve it
+// was quoted from: premiumIn = (premiumReserve * shieldOut) / (shieldReserve
+// - shieldOut). It is the single source of truth for that math, shared by
+// QuotePremium (which adds the fee, for display/charging the buyer) and
+// ApplyPurchase (which must add only the pre-fee amount to PremiumReserve to
+// keep the invariant that k is non-decreasing).
+func (k Keeper) quotePremium(ctx sdk.Context, poolID uint64, shieldOut sdk.Int) (sdk.Dec, types.PoolReserve, error) {
+	reserve, err := k.GetPoolReserve(ctx, poolID)
+	if err != nil {
+		return sdk.Dec{}, types.PoolReserve{}, err
+	}
+	if shieldOut.GTE(reserve.ShieldReserve) {
+		return sdk.Dec{}, types.PoolReserve{}, types.ErrInsufficientReserve
+	}
+	numerator := sdk.NewDecFromInt(reserve.PremiumReserve).MulInt(shieldOut)
+	denominator := sdk.NewDecFromInt(reserve.ShieldReserve.Sub(shieldOut))
+	return numerator.Quo(denominator), reserve, nil
+}
+
+// QuotePremium computes the fee-inclusive premium a buyer must pay to
+// purchase shieldOut coverage: the constant-product pre-fee premium from
+// quotePremium, with the governance fee rate applied on top,
+// premiumInWithFee = premiumIn / (1 - feeRate).
+func (k Keeper) QuotePremium(ctx sdk.Context, poolID uint64, shieldOut sdk.Int) (sdk.Int, error) {
+	premiumIn, _, err := k.quotePremium(ctx, poolID, shieldOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	feeRate := k.GetFeeRate(ctx)
+	if err := types.ValidateFeeRate(feeRate); err != nil {
+		return sdk.Int{}, sdkerrors.Wrap(types.ErrInvalidFeeRate, err.Error())
+	}
+	premiumInWithFee := premiumIn.Quo(sdk.OneDec().Sub(feeRate))
+
+	return premiumInWithFee.Ceil().TruncateInt(), nil
+}
+
+// ApplyPurchase updates a pool's reserves after a purchase of shieldOut
+// coverage, adding only the pre-fee premium (re-derived from quotePremium,
+// not the fee-inclusive amount charged to the buyer) to PremiumReserve so
+// the invariant that k is non-decreasing holds regardless of what the caller
+// quoted the buyer.
+func (k Keeper) ApplyPurchase(ctx sdk.Context, poolID uint64, shieldOut sdk.Int) error {
+	premiumIn, reserve, err := k.quotePremium(ctx, poolID, shieldOut)
+	if err != nil {
+		return err
+	}
+	reserve.ShieldReserve = reserve.ShieldReserve.Sub(shieldOut)
+	reserve.PremiumReserve = reserve.PremiumReserve.Add(premiumIn.Ceil().TruncateInt())
+	k.SetPoolReserve(ctx, reserve)
+	return nil
+}
+
+// QueryQuotePremium exposes QuotePremium for clients.
+func (k Keeper) QueryQuotePremium(ctx sdk.Context, poolID uint64, shieldOut sdk.Int) (sdk.Int, error) {
+	return k.QuotePremium(ctx, poolID, shieldOut)
+}
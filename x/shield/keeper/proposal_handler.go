@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/certikfoundation/shentu/x/shield/types"
+)
+
+// HandleShieldCreatePoolProposal creates a new shield pool on proposal
+// passage. Unlike CreatePool, it is not gated on the admin's signature, and
+// the premium deposit is funded from the module account rather than a user.
+func (k Keeper) HandleShieldCreatePoolProposal(ctx sdk.Context, proposal types.ShieldCreatePoolProposal) error {
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleName)
+
+	if !k.ValidatePoolDuration(ctx, proposal.TimeOfCoverage, proposal.BlocksOfCoverage) {
+		return types.ErrPoolLifeTooShort
+	}
+
+	admin := k.GetAdmin(ctx)
+	provider, found := k.GetProvider(ctx, admin)
+	if !found {
+		k.addProvider(ctx, admin)
+		provider, _ = k.GetProvider(ctx, admin)
+	}
+	provider.Collateral = provider.Collateral.Add(proposal.Shield...)
+	if proposal.Shield.AmountOf(k.sk.BondDenom(ctx)).GT(provider.Available) {
+		return types.ErrInsufficientStaking
+	}
+	provider.Available = provider.Available.Sub(proposal.Shield.AmountOf(k.sk.BondDenom(ctx)))
+
+	var endTime, endBlockHeight int64
+	startBlockHeight := ctx.BlockHeight()
+	if proposal.TimeOfCoverage != 0 {
+		endTime = ctx.BlockHeader().Time.Unix() + proposal.TimeOfCoverage
+	} else if proposal.BlocksOfCoverage != 0 {
+		endBlockHeight = startBlockHeight + proposal.BlocksOfCoverage
+	}
+
+	id := k.GetNextPoolID(ctx)
+	depositDec := types.MixedDecCoinsFromMixedCoins(proposal.Deposit)
+	pool := types.NewPool(proposal.Shield, depositDec, proposal.Sponsor, endTime, startBlockHeight, endBlockHeight, id)
+
+	if err := k.DepositNativePremium(ctx, proposal.Deposit.Native, moduleAddr); err != nil {
+		return err
+	}
+
+	k.SetPool(ctx, pool)
+	k.SetNextPoolID(ctx, id+1)
+	k.SetProvider(ctx, admin, provider)
+	k.SetCollateral(ctx, pool, admin, types.NewCollateral(pool, admin, proposal.Shield))
+	k.SeedPoolReserve(ctx, id, proposal.Shield.AmountOf(k.sk.BondDenom(ctx)), proposal.Deposit.Native.AmountOf(k.sk.BondDenom(ctx)))
+	// Increment the accumulator with the creation premium before
+	// snapshotting the admin's start info, mirroring CreatePool, so the
+	// admin's own contributed premium is excluded from its accrual.
+	k.IncrementCumulativePremiumPerShare(ctx, pool, proposal.Deposit.Native)
+	k.SnapshotPremiumStartInfo(ctx, id, admin)
+
+	return nil
+}
+
+// HandleShieldUpdatePoolProposal updates an existing shield pool on proposal
+// passage, funding the additional premium deposit from the module account.
+func (k Keeper) HandleShieldUpdatePoolProposal(ctx sdk.Context, proposal types.ShieldUpdatePoolProposal) error {
+	moduleAddr := k.supplyKeeper.GetModuleAddress(types.ModuleName)
+	admin := k.GetAdmin(ctx)
+
+	provider, found := k.GetProvider(ctx, admin)
+	if !found {
+		return types.ErrNoDelegationAmount
+	}
+	provider.Collateral = provider.Collateral.Add(proposal.Shield...)
+	if proposal.Shield.AmountOf(k.sk.BondDenom(ctx)).GT(provider.Available) {
+		return types.ErrInsufficientStaking
+	}
+	provider.Available = provider.Available.Sub(proposal.Shield.AmountOf(k.sk.BondDenom(ctx)))
+
+	pool, err := k.GetPool(ctx, proposal.PoolID)
+	if err != nil {
+		return err
+	}
+	if !k.ValidatePoolDuration(ctx, proposal.AdditionalTime, proposal.AdditionalBlocks) {
+		return types.ErrPoolLifeTooShort
+	}
+	if proposal.AdditionalTime != 0 {
+		pool.EndTime += proposal.AdditionalTime
+	} else if proposal.AdditionalBlocks != 0 {
+		pool.EndBlockHeight += proposal.AdditionalBlocks
+	}
+
+	pool.TotalCollateral = pool.TotalCollateral.Add(proposal.Shield...)
+	poolCertiKCollateral, found := k.GetPoolCertiKCollateral(ctx, pool)
+	if !found {
+		poolCertiKCollateral = types.NewCollateral(pool, admin, sdk.Coins{})
+	}
+	// Settle the admin's reward accrued on its pre-update collateral before
+	// that collateral (and the accumulator, below) change underneath it, so
+	// the update doesn't silently forfeit it, mirroring UpdatePool.
+	if err := k.PayOutAccruedShieldReward(ctx, proposal.PoolID, admin, poolCertiKCollateral.Amount); err != nil {
+		return err
+	}
+	poolCertiKCollateral.Amount = poolCertiKCollateral.Amount.Add(proposal.Shield...)
+
+	pool.Shield = pool.Shield.Add(proposal.Shield...)
+	pool.Premium = pool.Premium.Add(types.MixedDecCoinsFromMixedCoins(proposal.Deposit))
+
+	if err := k.DepositNativePremium(ctx, proposal.Deposit.Native, moduleAddr); err != nil {
+		return err
+	}
+
+	k.SetCollateral(ctx, pool, admin, poolCertiKCollateral)
+	k.SetPool(ctx, pool)
+	k.SetProvider(ctx, admin, provider)
+	if err := k.AdjustPoolReserve(ctx, proposal.PoolID, proposal.Shield.AmountOf(k.sk.BondDenom(ctx))); err != nil {
+		return err
+	}
+	// Increment the accumulator with this deposit's premium before
+	// snapshotting the admin's start info, mirroring UpdatePool.
+	k.IncrementCumulativePremiumPerShare(ctx, pool, proposal.Deposit.Native)
+	k.SnapshotPremiumStartInfo(ctx, proposal.PoolID, admin)
+	k.ClearDepletionIfRestored(ctx, pool)
+	return nil
+}
+
+// HandleShieldPausePoolProposal pauses an existing shield pool on proposal
+// passage.
+func (k Keeper) HandleShieldPausePoolProposal(ctx sdk.Context, proposal types.ShieldPausePoolProposal) error {
+	pool, err := k.GetPool(ctx, proposal.PoolID)
+	if err != nil {
+		return err
+	}
+	if !pool.Active {
+		return types.ErrPoolAlreadyPaused
+	}
+	pool.Active = false
+	k.SetPool(ctx, pool)
+	return nil
+}
+
+// HandleShieldResumePoolProposal resumes a paused shield pool on proposal
+// passage.
+func (k Keeper) HandleShieldResumePoolProposal(ctx sdk.Context, proposal types.ShieldResumePoolProposal) error {
+	pool, err := k.GetPool(ctx, proposal.PoolID)
+	if err != nil {
+		return err
+	}
+	if pool.Active {
+		return types.ErrPoolAlreadyActive
+	}
+	if pool.Depleted {
+		return types.ErrPoolDepleted
+	}
+	pool.Active = true
+	k.SetPool(ctx, pool)
+	return nil
+}
+
+// HandleShieldClosePoolProposal closes an existing shield pool on proposal
+// passage.
+func (k Keeper) HandleShieldClosePoolProposal(ctx sdk.Context, proposal types.ShieldClosePoolProposal) error {
+	pool, err := k.GetPool(ctx, proposal.PoolID)
+	if err != nil {
+		return err
+	}
+	k.ClosePool(ctx, pool)
+	return nil
+}
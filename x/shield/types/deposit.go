@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolDeposit tracks a single provider's collateral backing in a shield pool,
+// alongside the share of the pool's total collateral that backing represents.
+// SharePercent is recomputed on every deposit, withdrawal, or pool mutation
+// that changes TotalCollateral.
+type PoolDeposit struct {
+	PoolID       uint64         `json:"pool_id" yaml:"pool_id"`
+	Provider     sdk.AccAddress `json:"provider" yaml:"provider"`
+	Collateral   sdk.Coins      `json:"collateral" yaml:"collateral"`
+	SharePercent sdk.Dec        `json:"share_percent" yaml:"share_percent"`
+}
+
+// NewPoolDeposit creates a new PoolDeposit with a zero share percent; the
+// caller is expected to recompute shares immediately after.
+func NewPoolDeposit(poolID uint64, provider sdk.AccAddress, collateral sdk.Coins) PoolDeposit {
+	return PoolDeposit{
+		PoolID:       poolID,
+		Provider:     provider,
+		Collateral:   collateral,
+		SharePercent: sdk.ZeroDec(),
+	}
+}
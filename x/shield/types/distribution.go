@@ -0,0 +1,24 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PremiumStartInfo snapshots the pool's CumulativePremiumPerShare at the
+// moment a provider's collateral in that pool last changed, so that
+// withdrawing rewards only pays out premium accrued for the provider's
+// actual time-in-pool.
+type PremiumStartInfo struct {
+	PoolID           uint64         `json:"pool_id" yaml:"pool_id"`
+	Provider         sdk.AccAddress `json:"provider" yaml:"provider"`
+	CumulativeAtJoin sdk.DecCoins   `json:"cumulative_at_join" yaml:"cumulative_at_join"`
+}
+
+// NewPremiumStartInfo creates a new PremiumStartInfo snapshot.
+func NewPremiumStartInfo(poolID uint64, provider sdk.AccAddress, cumulative sdk.DecCoins) PremiumStartInfo {
+	return PremiumStartInfo{
+		PoolID:           poolID,
+		Provider:         provider,
+		CumulativeAtJoin: cumulative,
+	}
+}
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Errors for pool depletion.
+var (
+	ErrPoolDepleted              = sdkerrors.Register(ModuleName, 109, "pool is depleted and cannot accept new purchases or be resumed")
+	ErrInvalidDepletionThreshold = sdkerrors.Register(ModuleName, 111, "depletion threshold must be in (0, 1]")
+)
@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Errors for multi-provider pool deposits.
+var (
+	ErrNoPoolDepositFound  = sdkerrors.Register(ModuleName, 101, "no pool deposit found")
+	ErrInvalidCollateral   = sdkerrors.Register(ModuleName, 102, "invalid collateral amount")
+	ErrInsufficientDeposit = sdkerrors.Register(ModuleName, 103, "insufficient deposit to withdraw")
+	ErrEmptySponsorAddr    = sdkerrors.Register(ModuleName, 104, "sponsor address cannot be empty")
+)
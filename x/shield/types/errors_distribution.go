@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Errors for shield reward distribution.
+var (
+	ErrNoPremiumStartInfoFound = sdkerrors.Register(ModuleName, 107, "no premium start info found for provider in pool")
+	ErrNoRewardsToWithdraw     = sdkerrors.Register(ModuleName, 108, "no shield rewards to withdraw")
+)
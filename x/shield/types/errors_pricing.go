@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Errors for constant-product premium pricing.
+var (
+	ErrInsufficientReserve = sdkerrors.Register(ModuleName, 105, "purchase would exhaust pool's shield reserve")
+	ErrNoPoolReserveFound  = sdkerrors.Register(ModuleName, 106, "no pool reserve found")
+	ErrInvalidFeeRate      = sdkerrors.Register(ModuleName, 110, "fee rate must be in [0, 1)")
+)
@@ -0,0 +1,10 @@
+package types
+
+// Shield pool depletion event types and attribute keys.
+const (
+	EventTypePoolDepleted = "pool_depleted"
+
+	AttributeKeyPoolID          = "pool_id"
+	AttributeKeyTotalAvailable  = "total_available"
+	AttributeKeyRequiredBacking = "required_backing"
+)
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolDepositKey is the prefix for keys storing PoolDeposit records, keyed by
+// (poolID, provider) so each provider's backing in a given pool can be
+// looked up and iterated independently of the pool's admin-only collateral.
+var PoolDepositKey = []byte{0x21}
+
+// GetPoolDepositKey returns the store key for a PoolDeposit of the given pool
+// and provider.
+func GetPoolDepositKey(poolID uint64, provider sdk.AccAddress) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, poolID)
+	return append(append(PoolDepositKey, b...), provider.Bytes()...)
+}
+
+// GetPoolDepositsKey returns the prefix under which all PoolDeposit records
+// for a given pool are stored, for use with an iterator.
+func GetPoolDepositsKey(poolID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, poolID)
+	return append(PoolDepositKey, b...)
+}
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CumulativePremiumPerShareKey is the prefix for a pool's running
+// premium-per-share accumulator.
+var CumulativePremiumPerShareKey = []byte{0x23}
+
+// PremiumStartInfoKey is the prefix for a (pool, provider) premium start
+// snapshot.
+var PremiumStartInfoKey = []byte{0x24}
+
+// GetCumulativePremiumPerShareKey returns the store key for a pool's
+// premium-per-share accumulator.
+func GetCumulativePremiumPerShareKey(poolID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, poolID)
+	return append(CumulativePremiumPerShareKey, b...)
+}
+
+// GetPremiumStartInfoKey returns the store key for a provider's premium
+// start snapshot in the given pool.
+func GetPremiumStartInfoKey(poolID uint64, provider sdk.AccAddress) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, poolID)
+	return append(append(PremiumStartInfoKey, b...), provider.Bytes()...)
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+// PoolReserveKey is the prefix for keys storing a pool's constant-product
+// reserve pair.
+var PoolReserveKey = []byte{0x22}
+
+// GetPoolReserveKey returns the store key for a pool's PoolReserve.
+func GetPoolReserveKey(poolID uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, poolID)
+	return append(PoolReserveKey, b...)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgDepositCollateral  = "deposit_collateral"
+	TypeMsgWithdrawCollateral = "withdraw_collateral"
+)
+
+var (
+	_ sdk.Msg = MsgDepositCollateral{}
+	_ sdk.Msg = MsgWithdrawCollateral{}
+)
+
+// MsgDepositCollateral defines a message for a provider to back a pool with
+// additional collateral, becoming (or remaining) one of its depositors.
+type MsgDepositCollateral struct {
+	PoolID     uint64         `json:"pool_id" yaml:"pool_id"`
+	Provider   sdk.AccAddress `json:"provider" yaml:"provider"`
+	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
+}
+
+// NewMsgDepositCollateral creates a new MsgDepositCollateral instance.
+func NewMsgDepositCollateral(poolID uint64, provider sdk.AccAddress, collateral sdk.Coins) MsgDepositCollateral {
+	return MsgDepositCollateral{
+		PoolID:     poolID,
+		Provider:   provider,
+		Collateral: collateral,
+	}
+}
+
+func (msg MsgDepositCollateral) Route() string { return RouterKey }
+func (msg MsgDepositCollateral) Type() string   { return TypeMsgDepositCollateral }
+
+func (msg MsgDepositCollateral) ValidateBasic() error {
+	if msg.Provider.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing provider address")
+	}
+	if !msg.Collateral.IsValid() || !msg.Collateral.IsAllPositive() {
+		return ErrInvalidCollateral
+	}
+	return nil
+}
+
+func (msg MsgDepositCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgDepositCollateral) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Provider}
+}
+
+// MsgWithdrawCollateral defines a message for a provider to withdraw some of
+// its collateral backing a pool.
+type MsgWithdrawCollateral struct {
+	PoolID     uint64         `json:"pool_id" yaml:"pool_id"`
+	Provider   sdk.AccAddress `json:"provider" yaml:"provider"`
+	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
+}
+
+// NewMsgWithdrawCollateral creates a new MsgWithdrawCollateral instance.
+func NewMsgWithdrawCollateral(poolID uint64, provider sdk.AccAddress, collateral sdk.Coins) MsgWithdrawCollateral {
+	return MsgWithdrawCollateral{
+		PoolID:     poolID,
+		Provider:   provider,
+		Collateral: collateral,
+	}
+}
+
+func (msg MsgWithdrawCollateral) Route() string { return RouterKey }
+func (msg MsgWithdrawCollateral) Type() string   { return TypeMsgWithdrawCollateral }
+
+func (msg MsgWithdrawCollateral) ValidateBasic() error {
+	if msg.Provider.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing provider address")
+	}
+	if !msg.Collateral.IsValid() || !msg.Collateral.IsAllPositive() {
+		return ErrInvalidCollateral
+	}
+	return nil
+}
+
+func (msg MsgWithdrawCollateral) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawCollateral) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Provider}
+}
@@ -0,0 +1,78 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgWithdrawShieldRewards         = "withdraw_shield_rewards"
+	TypeMsgWithdrawShieldRewardsFromPool = "withdraw_shield_rewards_from_pool"
+)
+
+var (
+	_ sdk.Msg = MsgWithdrawShieldRewards{}
+	_ sdk.Msg = MsgWithdrawShieldRewardsFromPool{}
+)
+
+// MsgWithdrawShieldRewards withdraws a delegator's accrued shield premium
+// rewards across every pool it backs.
+type MsgWithdrawShieldRewards struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+}
+
+// NewMsgWithdrawShieldRewards creates a new MsgWithdrawShieldRewards instance.
+func NewMsgWithdrawShieldRewards(delegator sdk.AccAddress) MsgWithdrawShieldRewards {
+	return MsgWithdrawShieldRewards{Delegator: delegator}
+}
+
+func (msg MsgWithdrawShieldRewards) Route() string { return RouterKey }
+func (msg MsgWithdrawShieldRewards) Type() string   { return TypeMsgWithdrawShieldRewards }
+
+func (msg MsgWithdrawShieldRewards) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	return nil
+}
+
+func (msg MsgWithdrawShieldRewards) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawShieldRewards) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
+
+// MsgWithdrawShieldRewardsFromPool withdraws a delegator's accrued shield
+// premium rewards from a single pool.
+type MsgWithdrawShieldRewardsFromPool struct {
+	Delegator sdk.AccAddress `json:"delegator" yaml:"delegator"`
+	PoolID    uint64         `json:"pool_id" yaml:"pool_id"`
+}
+
+// NewMsgWithdrawShieldRewardsFromPool creates a new
+// MsgWithdrawShieldRewardsFromPool instance.
+func NewMsgWithdrawShieldRewardsFromPool(delegator sdk.AccAddress, poolID uint64) MsgWithdrawShieldRewardsFromPool {
+	return MsgWithdrawShieldRewardsFromPool{Delegator: delegator, PoolID: poolID}
+}
+
+func (msg MsgWithdrawShieldRewardsFromPool) Route() string { return RouterKey }
+func (msg MsgWithdrawShieldRewardsFromPool) Type() string {
+	return TypeMsgWithdrawShieldRewardsFromPool
+}
+
+func (msg MsgWithdrawShieldRewardsFromPool) ValidateBasic() error {
+	if msg.Delegator.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing delegator address")
+	}
+	return nil
+}
+
+func (msg MsgWithdrawShieldRewardsFromPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg MsgWithdrawShieldRewardsFromPool) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Delegator}
+}
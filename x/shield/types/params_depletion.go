@@ -0,0 +1,30 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KeyDepletionThreshold is the param store key for the fraction of a pool's
+// Shield that its backers' total available collateral must still cover
+// before the pool is marked Depleted.
+var KeyDepletionThreshold = []byte("DepletionThreshold")
+
+// DefaultDepletionThreshold is applied when the param has not been set by
+// governance.
+var DefaultDepletionThreshold = sdk.NewDecWithPrec(95, 2) // 0.95
+
+// ValidateDepletionThreshold rejects any threshold outside (0, 1], since a
+// non-positive threshold would never trip and one above 1 could never be
+// satisfied.
+func ValidateDepletionThreshold(i interface{}) error {
+	threshold, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for depletion threshold: %T", i)
+	}
+	if threshold.IsNil() || !threshold.IsPositive() || threshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("depletion threshold must be in (0, 1], got %s", threshold)
+	}
+	return nil
+}
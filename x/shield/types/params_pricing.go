@@ -0,0 +1,28 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KeyFeeRate is the param store key for the pricing fee rate applied on top
+// of the constant-product quote for every shield purchase.
+var KeyFeeRate = []byte("FeeRate")
+
+// DefaultFeeRate is applied when the param has not been set by governance.
+var DefaultFeeRate = sdk.NewDecWithPrec(1, 3) // 0.1%
+
+// ValidateFeeRate rejects any fee rate outside [0, 1), since
+// premiumIn / (1 - feeRate) divides by zero at feeRate == 1 and produces a
+// negative premium above it.
+func ValidateFeeRate(i interface{}) error {
+	feeRate, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type for fee rate: %T", i)
+	}
+	if feeRate.IsNil() || feeRate.IsNegative() || feeRate.GTE(sdk.OneDec()) {
+		return fmt.Errorf("fee rate must be in [0, 1), got %s", feeRate)
+	}
+	return nil
+}
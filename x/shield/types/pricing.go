@@ -0,0 +1,26 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PoolReserve tracks a pool's virtual constant-product reserves used to
+// auto-price shield purchases: ShieldReserve mirrors the pool's remaining
+// unsold shield coverage and PremiumReserve mirrors the premium paid in so
+// far. The product of the two (k) only ever grows, except when fee is
+// extracted from a purchase.
+type PoolReserve struct {
+	PoolID         uint64  `json:"pool_id" yaml:"pool_id"`
+	ShieldReserve  sdk.Int `json:"shield_reserve" yaml:"shield_reserve"`
+	PremiumReserve sdk.Int `json:"premium_reserve" yaml:"premium_reserve"`
+}
+
+// NewPoolReserve seeds a PoolReserve from a pool's initial shield and native
+// premium deposit.
+func NewPoolReserve(poolID uint64, shield, premium sdk.Int) PoolReserve {
+	return PoolReserve{
+		PoolID:         poolID,
+		ShieldReserve:  shield,
+		PremiumReserve: premium,
+	}
+}
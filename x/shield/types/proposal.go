@@ -0,0 +1,220 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	ProposalTypeShieldCreatePool = "ShieldCreatePool"
+	ProposalTypeShieldUpdatePool = "ShieldUpdatePool"
+	ProposalTypeShieldPausePool  = "ShieldPausePool"
+	ProposalTypeShieldResumePool = "ShieldResumePool"
+	ProposalTypeShieldClosePool  = "ShieldClosePool"
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeShieldCreatePool)
+	govtypes.RegisterProposalTypeCodec(ShieldCreatePoolProposal{}, "shield/ShieldCreatePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeShieldUpdatePool)
+	govtypes.RegisterProposalTypeCodec(ShieldUpdatePoolProposal{}, "shield/ShieldUpdatePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeShieldPausePool)
+	govtypes.RegisterProposalTypeCodec(ShieldPausePoolProposal{}, "shield/ShieldPausePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeShieldResumePool)
+	govtypes.RegisterProposalTypeCodec(ShieldResumePoolProposal{}, "shield/ShieldResumePoolProposal")
+	govtypes.RegisterProposalType(ProposalTypeShieldClosePool)
+	govtypes.RegisterProposalTypeCodec(ShieldClosePoolProposal{}, "shield/ShieldClosePoolProposal")
+}
+
+// ShieldCreatePoolProposal creates a new shield pool via governance instead
+// of requiring the admin account, funding the premium from the module
+// account on execution.
+type ShieldCreatePoolProposal struct {
+	Title            string         `json:"title" yaml:"title"`
+	Description      string         `json:"description" yaml:"description"`
+	Shield           sdk.Coins      `json:"shield" yaml:"shield"`
+	Deposit          MixedCoins     `json:"deposit" yaml:"deposit"`
+	Sponsor          string         `json:"sponsor" yaml:"sponsor"`
+	SponsorAddr      sdk.AccAddress `json:"sponsor_addr" yaml:"sponsor_addr"`
+	TimeOfCoverage   int64          `json:"time_of_coverage" yaml:"time_of_coverage"`
+	BlocksOfCoverage int64          `json:"blocks_of_coverage" yaml:"blocks_of_coverage"`
+}
+
+func NewShieldCreatePoolProposal(
+	title, description string, shield sdk.Coins, deposit MixedCoins, sponsor string,
+	sponsorAddr sdk.AccAddress, timeOfCoverage, blocksOfCoverage int64,
+) ShieldCreatePoolProposal {
+	return ShieldCreatePoolProposal{
+		Title:            title,
+		Description:      description,
+		Shield:           shield,
+		Deposit:          deposit,
+		Sponsor:          sponsor,
+		SponsorAddr:      sponsorAddr,
+		TimeOfCoverage:   timeOfCoverage,
+		BlocksOfCoverage: blocksOfCoverage,
+	}
+}
+
+func (sp ShieldCreatePoolProposal) GetTitle() string       { return sp.Title }
+func (sp ShieldCreatePoolProposal) GetDescription() string { return sp.Description }
+func (sp ShieldCreatePoolProposal) ProposalRoute() string  { return RouterKey }
+func (sp ShieldCreatePoolProposal) ProposalType() string   { return ProposalTypeShieldCreatePool }
+
+func (sp ShieldCreatePoolProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sp); err != nil {
+		return err
+	}
+	if !sp.Shield.IsValid() || !sp.Shield.IsAllPositive() {
+		return ErrInvalidCollateral
+	}
+	if sp.SponsorAddr.Empty() {
+		return ErrEmptySponsorAddr
+	}
+	return nil
+}
+
+func (sp ShieldCreatePoolProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Shield Create Pool Proposal:
+  Title:              %s
+  Description:        %s
+  Shield:             %s
+  Deposit:            %s
+  Sponsor:            %s
+  SponsorAddr:        %s
+  TimeOfCoverage:     %d
+  BlocksOfCoverage:   %d
+`, sp.Title, sp.Description, sp.Shield, sp.Deposit, sp.Sponsor, sp.SponsorAddr, sp.TimeOfCoverage, sp.BlocksOfCoverage))
+	return b.String()
+}
+
+// ShieldUpdatePoolProposal updates an existing shield pool via governance.
+type ShieldUpdatePoolProposal struct {
+	Title            string     `json:"title" yaml:"title"`
+	Description      string     `json:"description" yaml:"description"`
+	PoolID           uint64     `json:"pool_id" yaml:"pool_id"`
+	Shield           sdk.Coins  `json:"shield" yaml:"shield"`
+	Deposit          MixedCoins `json:"deposit" yaml:"deposit"`
+	AdditionalTime   int64      `json:"additional_time" yaml:"additional_time"`
+	AdditionalBlocks int64      `json:"additional_blocks" yaml:"additional_blocks"`
+}
+
+func NewShieldUpdatePoolProposal(
+	title, description string, poolID uint64, shield sdk.Coins, deposit MixedCoins,
+	additionalTime, additionalBlocks int64,
+) ShieldUpdatePoolProposal {
+	return ShieldUpdatePoolProposal{
+		Title:            title,
+		Description:      description,
+		PoolID:           poolID,
+		Shield:           shield,
+		Deposit:          deposit,
+		AdditionalTime:   additionalTime,
+		AdditionalBlocks: additionalBlocks,
+	}
+}
+
+func (sp ShieldUpdatePoolProposal) GetTitle() string       { return sp.Title }
+func (sp ShieldUpdatePoolProposal) GetDescription() string { return sp.Description }
+func (sp ShieldUpdatePoolProposal) ProposalRoute() string  { return RouterKey }
+func (sp ShieldUpdatePoolProposal) ProposalType() string   { return ProposalTypeShieldUpdatePool }
+
+func (sp ShieldUpdatePoolProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(sp); err != nil {
+		return err
+	}
+	if !sp.Shield.IsValid() {
+		return ErrInvalidCollateral
+	}
+	return nil
+}
+
+func (sp ShieldUpdatePoolProposal) String() string {
+	return fmt.Sprintf(`Shield Update Pool Proposal:
+  Title:              %s
+  Description:        %s
+  PoolID:             %d
+  Shield:             %s
+  Deposit:            %s
+`, sp.Title, sp.Description, sp.PoolID, sp.Shield, sp.Deposit)
+}
+
+// ShieldPausePoolProposal pauses an existing shield pool via governance.
+type ShieldPausePoolProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	PoolID      uint64 `json:"pool_id" yaml:"pool_id"`
+}
+
+func NewShieldPausePoolProposal(title, description string, poolID uint64) ShieldPausePoolProposal {
+	return ShieldPausePoolProposal{Title: title, Description: description, PoolID: poolID}
+}
+
+func (sp ShieldPausePoolProposal) GetTitle() string       { return sp.Title }
+func (sp ShieldPausePoolProposal) GetDescription() string { return sp.Description }
+func (sp ShieldPausePoolProposal) ProposalRoute() string  { return RouterKey }
+func (sp ShieldPausePoolProposal) ProposalType() string   { return ProposalTypeShieldPausePool }
+func (sp ShieldPausePoolProposal) ValidateBasic() error   { return govtypes.ValidateAbstract(sp) }
+
+func (sp ShieldPausePoolProposal) String() string {
+	return fmt.Sprintf(`Shield Pause Pool Proposal:
+  Title:              %s
+  Description:        %s
+  PoolID:             %d
+`, sp.Title, sp.Description, sp.PoolID)
+}
+
+// ShieldResumePoolProposal resumes a previously paused shield pool via
+// governance.
+type ShieldResumePoolProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	PoolID      uint64 `json:"pool_id" yaml:"pool_id"`
+}
+
+func NewShieldResumePoolProposal(title, description string, poolID uint64) ShieldResumePoolProposal {
+	return ShieldResumePoolProposal{Title: title, Description: description, PoolID: poolID}
+}
+
+func (sp ShieldResumePoolProposal) GetTitle() string       { return sp.Title }
+func (sp ShieldResumePoolProposal) GetDescription() string { return sp.Description }
+func (sp ShieldResumePoolProposal) ProposalRoute() string  { return RouterKey }
+func (sp ShieldResumePoolProposal) ProposalType() string   { return ProposalTypeShieldResumePool }
+func (sp ShieldResumePoolProposal) ValidateBasic() error   { return govtypes.ValidateAbstract(sp) }
+
+func (sp ShieldResumePoolProposal) String() string {
+	return fmt.Sprintf(`Shield Resume Pool Proposal:
+  Title:              %s
+  Description:        %s
+  PoolID:             %d
+`, sp.Title, sp.Description, sp.PoolID)
+}
+
+// ShieldClosePoolProposal closes an existing shield pool via governance.
+type ShieldClosePoolProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	PoolID      uint64 `json:"pool_id" yaml:"pool_id"`
+}
+
+func NewShieldClosePoolProposal(title, description string, poolID uint64) ShieldClosePoolProposal {
+	return ShieldClosePoolProposal{Title: title, Description: description, PoolID: poolID}
+}
+
+func (sp ShieldClosePoolProposal) GetTitle() string       { return sp.Title }
+func (sp ShieldClosePoolProposal) GetDescription() string { return sp.Description }
+func (sp ShieldClosePoolProposal) ProposalRoute() string  { return RouterKey }
+func (sp ShieldClosePoolProposal) ProposalType() string   { return ProposalTypeShieldClosePool }
+func (sp ShieldClosePoolProposal) ValidateBasic() error   { return govtypes.ValidateAbstract(sp) }
+
+func (sp ShieldClosePoolProposal) String() string {
+	return fmt.Sprintf(`Shield Close Pool Proposal:
+  Title:              %s
+  Description:        %s
+  PoolID:             %d
+`, sp.Title, sp.Description, sp.PoolID)
+}